@@ -1,7 +1,9 @@
 package pipe
 
 import (
+	"context"
 	"math"
+	"sort"
 	"sync"
 
 	"github.com/koss-null/lambda/internal/bitmap"
@@ -21,6 +23,11 @@ type Pipe[T any] struct {
 	valLim   *int64
 	skip     func(i int)
 	parallel int
+	ctx      context.Context
+	// done, when set, is checked by doCtx alongside ctx so a stage like Take
+	// that already knows its answer can stop the worker pool from
+	// scheduling further indices. nil means "never done early".
+	done *atomic.Bool
 }
 
 // Slice creates a Pipe from a slice
@@ -43,6 +50,7 @@ func Slice[T any](dt []T) *Pipe[T] {
 		valLim:   &zero,
 		skip:     bm.SetTrue,
 		parallel: defaultParallelWrks,
+		ctx:      context.Background(),
 	}
 }
 
@@ -72,6 +80,7 @@ func Func[T any](fn func(i int) (T, bool)) *Pipe[T] {
 		valLim:   &zero,
 		skip:     bm.SetTrue,
 		parallel: defaultParallelWrks,
+		ctx:      context.Background(),
 	}
 }
 
@@ -91,6 +100,62 @@ func (p *Pipe[T]) Map(fn func(T) T) *Pipe[T] {
 		valLim:   p.valLim,
 		skip:     p.skip,
 		parallel: p.parallel,
+		ctx:      p.ctx,
+		done:     p.done,
+	}
+}
+
+// MapTo applies fn to each element of p, producing a Pipe of a different
+// type; it's a free function since Go methods can't add a new type
+// parameter to Pipe[T].
+func MapTo[T, U any](p *Pipe[T], fn func(T) U) *Pipe[U] {
+	return &Pipe[U]{
+		fn: func() func(i int) (*U, bool) {
+			return func(i int) (*U, bool) {
+				if obj, skipped := p.fn()(i); !skipped {
+					res := fn(*obj)
+					return &res, false
+				}
+				return nil, true
+			}
+		},
+		len:      p.len,
+		valLim:   p.valLim,
+		skip:     p.skip,
+		parallel: p.parallel,
+		ctx:      p.ctx,
+		done:     p.done,
+	}
+}
+
+// FlatMap applies fn to each element of p and flattens the results into a
+// Pipe[U]. One input can produce any number of outputs, so the index no
+// longer lines up with p; FlatMap evaluates p immediately and builds a
+// dense Pipe[U] over the flattened slice instead of staying lazy.
+func FlatMap[T, U any](p *Pipe[T], fn func(T) []U) *Pipe[U] {
+	data := p.Do()
+	flat := make([]U, 0, len(data))
+	for _, d := range data {
+		flat = append(flat, fn(d)...)
+	}
+
+	bm := bitmap.NewNaive(len(flat))
+	length := int64(len(flat))
+	zero := int64(0)
+	return &Pipe[U]{
+		fn: func() func(i int) (*U, bool) {
+			return func(i int) (*U, bool) {
+				if i < 0 || i >= len(flat) {
+					return nil, true
+				}
+				return &flat[i], false
+			}
+		},
+		len:      &length,
+		valLim:   &zero,
+		skip:     bm.SetTrue,
+		parallel: p.parallel,
+		ctx:      p.ctx,
 	}
 }
 
@@ -113,34 +178,203 @@ func (p *Pipe[T]) Filter(fn func(T) bool) *Pipe[T] {
 		valLim:   p.valLim,
 		skip:     p.skip,
 		parallel: p.parallel,
+		ctx:      p.ctx,
+		done:     p.done,
 	}
 }
 
-// Sort sorts the underlying slice
-// TO BE IMPLEMENTED
-// func (p *Pipe[T]) Sort(less func(T, T) bool) *Pipe[T] {
-// 	return &Pipe[T]{
-// 		fn: func() ([]T, []bool) {
-// 			data, skip := p.fn()
-// 			filtered := make([]T, 0, len(data)-*p.skipped)
-// 			for i := range data {
-// 				if !skip[i] {
-// 					filtered = append(filtered, data[i])
-// 				}
-// 			}
-// 			sort.Slice(
-// 				filtered,
-// 				func(i, j int) bool {
-// 					return less(filtered[i], filtered[j])
-// 				},
-// 			)
-// 			*p.skipped = 0
-// 			return filtered, make([]bool, len(filtered))
-// 		},
-// 		skipped:    p.skipped,
-// 		infinitSeq: p.infinitSeq,
-// 	}
-// }
+// Take keeps only the first n non-skipped items, flipping its done flag
+// once n is reached so doCtx stops scheduling further indices. Under
+// Parallel(n) above 1, workers race over indices, so the kept set isn't
+// guaranteed to be an exact prefix - use Parallel(1) if that matters.
+func (p *Pipe[T]) Take(n int) *Pipe[T] {
+	if n < 0 {
+		n = 0
+	}
+	var taken atomic.Int64
+	done := atomic.NewBool(false)
+	return &Pipe[T]{
+		fn: func() func(i int) (*T, bool) {
+			pfn := p.fn()
+			return func(i int) (*T, bool) {
+				if taken.Load() >= int64(n) {
+					done.Store(true)
+					return nil, true
+				}
+				obj, skipped := pfn(i)
+				if skipped {
+					return nil, true
+				}
+				if taken.Add(1) > int64(n) {
+					done.Store(true)
+					return nil, true
+				}
+				return obj, false
+			}
+		},
+		len:      p.len,
+		valLim:   p.valLim,
+		skip:     p.skip,
+		parallel: p.parallel,
+		ctx:      p.ctx,
+		done:     done,
+	}
+}
+
+// Skip drops the first n non-skipped items of p. Under Parallel(n) above 1,
+// workers race over indices, so the dropped items aren't guaranteed to be
+// an exact prefix - use Parallel(1) if that matters.
+func (p *Pipe[T]) Skip(n int) *Pipe[T] {
+	if n <= 0 {
+		return p
+	}
+	var seen atomic.Int64
+	return &Pipe[T]{
+		fn: func() func(i int) (*T, bool) {
+			pfn := p.fn()
+			return func(i int) (*T, bool) {
+				obj, skipped := pfn(i)
+				if skipped {
+					return nil, true
+				}
+				if seen.Add(1) <= int64(n) {
+					p.skip(i)
+					return nil, true
+				}
+				return obj, false
+			}
+		},
+		len:      p.len,
+		valLim:   p.valLim,
+		skip:     p.skip,
+		parallel: p.parallel,
+		ctx:      p.ctx,
+		done:     p.done,
+	}
+}
+
+// Sort sorts the underlying slice. Reordering needs the whole upstream
+// materialized first, so - like Reduce - it evaluates p immediately and
+// hands back a new dense Pipe built on the sorted result, with len set to
+// the post-filter count and the skip bitmap reset.
+func (p *Pipe[T]) Sort(less func(T, T) bool) *Pipe[T] {
+	data := p.Do()
+	sortParallel(data, less, p.parallel)
+
+	bm := bitmap.NewNaive(len(data))
+	length := int64(len(data))
+	zero := int64(0)
+	return &Pipe[T]{
+		fn: func() func(i int) (*T, bool) {
+			return func(i int) (*T, bool) {
+				if i < 0 || i >= len(data) {
+					return nil, true
+				}
+				return &data[i], false
+			}
+		},
+		len:      &length,
+		valLim:   &zero,
+		skip:     bm.SetTrue,
+		parallel: p.parallel,
+		ctx:      p.ctx,
+	}
+}
+
+// sortParallel sorts data in place by splitting it into at most parallel chunks,
+// sorting each chunk concurrently, then merging the sorted runs pairwise in
+// parallel until a single run remains. Falls back to a plain sort.Slice when
+// parallel is 1 or there isn't enough data to make splitting worthwhile.
+func sortParallel[T any](data []T, less func(T, T) bool, parallel int) {
+	sliceLess := func(s []T) func(int, int) bool {
+		return func(i, j int) bool { return less(s[i], s[j]) }
+	}
+
+	if parallel <= 1 || len(data) <= 1 {
+		sort.Slice(data, sliceLess(data))
+		return
+	}
+
+	runs := splitChunks(data, parallel)
+
+	wrks := make(chan struct{}, maxParallelWrks)
+	var wg sync.WaitGroup
+	wg.Add(len(runs))
+	for _, run := range runs {
+		wrks <- struct{}{}
+		go func(run []T) {
+			defer func() {
+				<-wrks
+				wg.Done()
+			}()
+			sort.Slice(run, sliceLess(run))
+		}(run)
+	}
+	wg.Wait()
+
+	for len(runs) > 1 {
+		next := make([][]T, (len(runs)+1)/2)
+		var mwg sync.WaitGroup
+		mwrks := make(chan struct{}, maxParallelWrks)
+		for i := 0; i < len(runs); i += 2 {
+			if i+1 == len(runs) {
+				next[i/2] = runs[i]
+				continue
+			}
+			mwg.Add(1)
+			mwrks <- struct{}{}
+			go func(idx int, a, b []T) {
+				defer func() {
+					<-mwrks
+					mwg.Done()
+				}()
+				next[idx] = mergeSorted(a, b, less)
+			}(i/2, runs[i], runs[i+1])
+		}
+		mwg.Wait()
+		runs = next
+	}
+
+	copy(data, runs[0])
+}
+
+// splitChunks splits data into at most n contiguous, roughly equal sub-slices.
+func splitChunks[T any](data []T, n int) [][]T {
+	if n > len(data) {
+		n = len(data)
+	}
+	if n < 1 {
+		n = 1
+	}
+	chunkSize := (len(data) + n - 1) / n
+	chunks := make([][]T, 0, n)
+	for i := 0; i < len(data); i += chunkSize {
+		end := i + chunkSize
+		if end > len(data) {
+			end = len(data)
+		}
+		chunks = append(chunks, data[i:end])
+	}
+	return chunks
+}
+
+// mergeSorted merges two sorted (per less) slices into a new sorted slice.
+func mergeSorted[T any](a, b []T, less func(T, T) bool) []T {
+	res := make([]T, 0, len(a)+len(b))
+	i, j := 0, 0
+	for i < len(a) && j < len(b) {
+		if less(b[j], a[i]) {
+			res = append(res, b[j])
+			j++
+		} else {
+			res = append(res, a[i])
+			i++
+		}
+	}
+	res = append(res, a[i:]...)
+	res = append(res, b[j:]...)
+	return res
+}
 
 // Reduce applies the result of a function to each element one-by-one
 func (p *Pipe[T]) Reduce(fn func(T, T) T) *T {
@@ -155,6 +389,156 @@ func (p *Pipe[T]) Reduce(fn func(T, T) T) *T {
 	return &res
 }
 
+// ReduceTo is the type-changing counterpart to Reduce, folding p into an
+// accumulator of a different type; a free function for the same reason as
+// MapTo.
+func ReduceTo[T, U any](p *Pipe[T], init U, fn func(U, T) U) U {
+	res := init
+	for _, v := range p.Do() {
+		res = fn(res, v)
+	}
+	return res
+}
+
+// First returns the first non-skipped item, or nil if there is none. It
+// scans sequentially regardless of Parallel, so the result is always the
+// true index-order-first item; use Any if you only need to know one exists
+// and want the early exit to take advantage of parallelism.
+func (p *Pipe[T]) First() *T {
+	if *p.len == -1 && *p.valLim == 0 {
+		return nil
+	}
+	if *p.valLim != 0 {
+		data := p.doToLimit()
+		if len(data) == 0 {
+			return nil
+		}
+		return &data[0]
+	}
+	pfn := p.fn()
+	for i := 0; i < int(*p.len); i++ {
+		if obj, skipped := pfn(i); !skipped {
+			return obj
+		}
+	}
+	return nil
+}
+
+// Any reports whether pred holds for at least one non-skipped item. Workers
+// stop scheduling new indices as soon as a match is found.
+func (p *Pipe[T]) Any(pred func(T) bool) bool {
+	if *p.len == -1 && *p.valLim == 0 {
+		return false
+	}
+	if *p.valLim != 0 {
+		pfn := p.fn()
+		found := int64(0)
+		for i := 0; found < *p.valLim && i < math.MaxInt; i++ {
+			if obj, skipped := pfn(i); !skipped {
+				found++
+				if pred(*obj) {
+					return true
+				}
+			}
+		}
+		return false
+	}
+	return p.scanEarly(pred)
+}
+
+// All reports whether pred holds for every non-skipped item. Workers stop
+// scheduling new indices as soon as a counterexample is found.
+func (p *Pipe[T]) All(pred func(T) bool) bool {
+	if *p.len == -1 && *p.valLim == 0 {
+		return true
+	}
+	if *p.valLim != 0 {
+		pfn := p.fn()
+		found := int64(0)
+		for i := 0; found < *p.valLim && i < math.MaxInt; i++ {
+			if obj, skipped := pfn(i); !skipped {
+				found++
+				if !pred(*obj) {
+					return false
+				}
+			}
+		}
+		return true
+	}
+	return !p.scanEarly(func(v T) bool { return !pred(v) })
+}
+
+// scanEarly is the shared early-exit evaluator behind Any and All: it runs
+// match over p's items on a pool of p.parallel workers and cancels a ctx
+// derived from p.ctx as soon as one worker finds a match, leaving p itself
+// untouched so it can still be reused after the scan.
+func (p *Pipe[T]) scanEarly(match func(T) bool) bool {
+	n := int(*p.len)
+	if n <= 0 {
+		return false
+	}
+	parallel := p.parallel
+	if parallel > n {
+		parallel = n
+	}
+	if parallel < 1 {
+		parallel = 1
+	}
+
+	ctx, cancel := context.WithCancel(p.ctx)
+	defer cancel()
+
+	var matched atomic.Bool
+	pfn := p.fn()
+	idxCh := make(chan int)
+	var wg sync.WaitGroup
+	wg.Add(parallel)
+	for w := 0; w < parallel; w++ {
+		go func() {
+			defer wg.Done()
+			for i := range idxCh {
+				if matched.Load() {
+					continue
+				}
+				if obj, skipped := pfn(i); !skipped && match(*obj) {
+					matched.Store(true)
+					cancel()
+				}
+			}
+		}()
+	}
+
+feed:
+	for i := 0; i < n; i++ {
+		select {
+		case <-ctx.Done():
+			break feed
+		case idxCh <- i:
+		}
+	}
+	close(idxCh)
+	wg.Wait()
+
+	return matched.Load()
+}
+
+// Numeric constrains the element types Sum can add together.
+type Numeric interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 |
+		~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~uintptr |
+		~float32 | ~float64
+}
+
+// Sum evaluates p and adds up its items; a free function so the Numeric
+// constraint doesn't have to attach to Pipe[T]'s own T.
+func Sum[T Numeric](p *Pipe[T]) T {
+	var sum T
+	for _, v := range p.Do() {
+		sum += v
+	}
+	return sum
+}
+
 // Get set the amount of values expected to be in result slice
 // Applied only the first Gen() or Get() function in the pipe
 func (p *Pipe[T]) Get(n int) *Pipe[T] {
@@ -200,51 +584,105 @@ type ev[T any] struct {
 }
 
 func (p *Pipe[T]) do(needResult bool) ([]T, int) {
+	res, cnt, _ := p.doCtx(p.ctx, needResult)
+	return res, cnt
+}
+
+// doCtx is the shared evaluator behind do, DoCtx and CountCtx. Unlike the
+// previous implementation, it never spawns more than p.parallel goroutines:
+// a fixed pool of workers pulls indices off idxCh instead of one goroutine
+// being launched per index, so memory stays bounded by p.parallel rather
+// than *p.len. ctx is checked both while feeding indices and inside each
+// worker, so a cancelled ctx stops new work from being scheduled and causes
+// doCtx to return ctx.Err() instead of a result. p.done is checked the same
+// way, so a stage like Take that already has its answer can stop the pool
+// early without needing a ctx of its own.
+//
+// evals defaults every index to skipped=true before any worker touches it,
+// so an index that's never fed to pfn (because ctx/p.done tripped early)
+// reads back as skipped rather than as a zero-value "kept" entry with a nil
+// obj - counting and result-building only ever look at indices pfn actually
+// ran on.
+func (p *Pipe[T]) doCtx(ctx context.Context, needResult bool) ([]T, int, error) {
 	if *p.len == -1 && *p.valLim == 0 {
-		return []T{}, 0
+		return []T{}, 0, nil
 	}
 
 	if *p.valLim != 0 {
-		res := p.doToLimit()
-		return res, len(res)
+		pfn := p.fn()
+		res := make([]T, 0, *p.valLim)
+		for i := 0; int64(len(res)) < *p.valLim && i < math.MaxInt; i++ {
+			if err := ctx.Err(); err != nil {
+				return nil, 0, err
+			}
+			if p.done != nil && p.done.Load() {
+				break
+			}
+			if obj, skipped := pfn(i); !skipped {
+				res = append(res, *obj)
+			}
+		}
+		return res, len(res), nil
 	}
 
-	var skipCnt atomic.Int64
-	var res []T
-	var evals []ev[T]
-	if needResult {
-		res = make([]T, 0, *p.len)
-		evals = make([]ev[T], *p.len)
+	n := int(*p.len)
+	parallel := p.parallel
+	if parallel > n {
+		parallel = n
+	}
+	if parallel < 1 {
+		parallel = 1
 	}
 
-	wrks := make(chan struct{}, p.parallel)
-	for i := 0; i < p.parallel; i++ {
-		wrks <- struct{}{}
+	var kept atomic.Int64
+	var res []T
+	evals := make([]ev[T], n)
+	for i := range evals {
+		evals[i] = ev[T]{nil, true}
 	}
-	var wg sync.WaitGroup
 
 	pfn := p.fn()
-	wg.Add(int(*p.len))
-	for i := 0; i < int(*p.len); i++ {
-		<-wrks
-		go func(i int) {
-			defer func() {
-				wrks <- struct{}{}
-				wg.Done()
-			}()
-
-			obj, skipped := pfn(i)
-			if skipped {
-				skipCnt.Add(1)
-			}
-			if needResult {
-				evals[i] = ev[T]{obj, skipped}
+	idxCh := make(chan int)
+	var wg sync.WaitGroup
+	wg.Add(parallel)
+	for w := 0; w < parallel; w++ {
+		go func() {
+			defer wg.Done()
+			for i := range idxCh {
+				if ctx.Err() != nil || (p.done != nil && p.done.Load()) {
+					continue
+				}
+				obj, skipped := pfn(i)
+				if !skipped {
+					kept.Add(1)
+				}
+				if needResult {
+					evals[i] = ev[T]{obj, skipped}
+				}
 			}
-		}(i)
+		}()
+	}
+
+feed:
+	for i := 0; i < n; i++ {
+		if p.done != nil && p.done.Load() {
+			break feed
+		}
+		select {
+		case <-ctx.Done():
+			break feed
+		case idxCh <- i:
+		}
 	}
+	close(idxCh)
 	wg.Wait()
 
+	if err := ctx.Err(); err != nil {
+		return nil, 0, err
+	}
+
 	if needResult {
+		res = make([]T, 0, kept.Load())
 		for _, ev := range evals {
 			if !ev.skipped {
 				res = append(res, *ev.obj)
@@ -252,7 +690,7 @@ func (p *Pipe[T]) do(needResult bool) ([]T, int) {
 		}
 	}
 
-	return res, int(*p.len - skipCnt.Load())
+	return res, int(kept.Load()), nil
 }
 
 // Parallel set n - the amount of goroutines to run on. The value by defalut is 4
@@ -268,12 +706,31 @@ func (p *Pipe[T]) Parallel(n int) *Pipe[T] {
 	return p
 }
 
+// WithContext attaches ctx to the pipe, so Do and Count stop scheduling new
+// work once ctx is cancelled; having no error result, Do then returns nil
+// and Count returns 0. DoCtx and CountCtx check the ctx passed to them
+// instead of this one. Only a non-nil ctx is applied.
+func (p *Pipe[T]) WithContext(ctx context.Context) *Pipe[T] {
+	if ctx == nil {
+		return p
+	}
+	p.ctx = ctx
+	return p
+}
+
 // Do evaluates all the pipeline and returns the result slice
 func (p *Pipe[T]) Do() []T {
 	res, _ := p.do(true)
 	return res
 }
 
+// DoCtx evaluates the pipeline like Do, but aborts and returns ctx.Err()
+// if ctx is cancelled before evaluation completes.
+func (p *Pipe[T]) DoCtx(ctx context.Context) ([]T, error) {
+	res, _, err := p.doCtx(ctx, true)
+	return res, err
+}
+
 // Count evaluates all the pipeline and returns the amount of left items
 func (p *Pipe[T]) Count() int {
 	if *p.valLim != 0 {
@@ -283,6 +740,16 @@ func (p *Pipe[T]) Count() int {
 	return cnt
 }
 
+// CountCtx evaluates the pipeline like Count, but aborts and returns
+// ctx.Err() if ctx is cancelled before evaluation completes.
+func (p *Pipe[T]) CountCtx(ctx context.Context) (int, error) {
+	if *p.valLim != 0 {
+		return int(*p.valLim), nil
+	}
+	_, cnt, err := p.doCtx(ctx, false)
+	return cnt, err
+}
+
 // func reduceSkipped[T any](data []T, skip []bool, skipped int) ([]T, []bool, int) {
 // 	if skipped > len(data)/2 {
 // 		res := make([]T, 0, len(data)-skipped)
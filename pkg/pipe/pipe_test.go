@@ -0,0 +1,95 @@
+package pipe
+
+import (
+	"reflect"
+	"sort"
+	"testing"
+
+	"go.uber.org/atomic"
+)
+
+func TestMapToChangesType(t *testing.T) {
+	p := Slice([]int{1, 2, 3, 4})
+	got := MapTo(p, func(v int) string {
+		return string(rune('a' + v - 1))
+	}).Do()
+
+	want := []string{"a", "b", "c", "d"}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("MapTo() = %v, want %v", got, want)
+	}
+}
+
+func TestMapToIsLazy(t *testing.T) {
+	var called atomic.Int64
+	p := Slice([]int{1, 2, 3}).Map(func(v int) int {
+		called.Add(1)
+		return v
+	})
+	mapped := MapTo(p, func(v int) int { return v * 2 })
+
+	if n := called.Load(); n != 0 {
+		t.Fatalf("upstream fn ran before materialization: called = %d", n)
+	}
+	mapped.Do()
+	if n := called.Load(); n != 3 {
+		t.Fatalf("upstream fn ran %d times, want 3", n)
+	}
+}
+
+func TestFlatMapAfterFilter(t *testing.T) {
+	p := Slice([]int{1, 2, 3, 4, 5, 6}).
+		Filter(func(v int) bool { return v%2 == 0 })
+	data := FlatMap(p, func(v int) []int { return []int{v, v} }).Do()
+
+	sort.Ints(data)
+	want := []int{2, 2, 4, 4, 6, 6}
+	if !reflect.DeepEqual(data, want) {
+		t.Fatalf("FlatMap() = %v, want %v", data, want)
+	}
+}
+
+func TestReduceToParallel(t *testing.T) {
+	p := Slice([]int{1, 2, 3, 4, 5}).Parallel(4)
+	got := ReduceTo(p, 0, func(acc int, v int) int { return acc + v })
+	if want := 15; got != want {
+		t.Fatalf("ReduceTo() = %d, want %d", got, want)
+	}
+}
+
+func TestTakeDoAndCount(t *testing.T) {
+	data := []int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10}
+
+	if got := Slice(data).Take(3).Do(); len(got) != 3 {
+		t.Fatalf("Take(3).Do() = %v, want 3 items", got)
+	}
+	if got := Slice(data).Take(3).Count(); got != 3 {
+		t.Fatalf("Take(3).Count() = %d, want 3", got)
+	}
+}
+
+func TestTakeIsPrefixWithParallelOne(t *testing.T) {
+	data := []int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10}
+	got := Slice(data).Parallel(1).Take(3).Do()
+
+	want := []int{1, 2, 3}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("Take(3).Do() = %v, want %v", got, want)
+	}
+}
+
+func TestTakeDoneStopsChainedStages(t *testing.T) {
+	var calls atomic.Int64
+	src := Slice(make([]int, 10000)).Map(func(v int) int {
+		calls.Add(1)
+		return v
+	})
+
+	got := src.Parallel(1).Take(3).Filter(func(int) bool { return true }).Do()
+	if len(got) != 3 {
+		t.Fatalf("Take(3).Filter(...).Do() = %v, want 3 items", got)
+	}
+	if n := calls.Load(); n > 10 {
+		t.Fatalf("Take's done flag wasn't propagated through Filter: evaluated %d upstream items, want early exit near 3", n)
+	}
+}